@@ -0,0 +1,73 @@
+//! Compares the serialization of large arrays with a baseline
+//! growing the output buffer without preallocation.
+//!
+//! Run with `cargo bench -p cainome-cairo-serde`.
+use cainome_cairo_serde::CairoSerde;
+use starknet::core::types::FieldElement;
+use std::hint::black_box;
+use std::time::{Duration, Instant};
+
+const ITERATIONS: u32 = 20;
+
+fn baseline_serialize(rust: &[FieldElement]) -> Vec<FieldElement> {
+    let mut out: Vec<FieldElement> = vec![rust.len().into()];
+    rust.iter()
+        .for_each(|r| out.extend(FieldElement::cairo_serialize(r)));
+    out
+}
+
+fn baseline_serialize_nested(rust: &[Vec<FieldElement>]) -> Vec<FieldElement> {
+    let mut out: Vec<FieldElement> = vec![rust.len().into()];
+    rust.iter().for_each(|r| out.extend(baseline_serialize(r)));
+    out
+}
+
+fn measure<F: FnMut() -> Vec<FieldElement>>(mut f: F) -> Duration {
+    // Warm up.
+    black_box(f());
+
+    let start = Instant::now();
+    for _ in 0..ITERATIONS {
+        black_box(f());
+    }
+
+    start.elapsed() / ITERATIONS
+}
+
+fn report(name: &str, baseline: Duration, preallocated: Duration) {
+    println!(
+        "{:<36} baseline: {:>10.3?}  preallocated: {:>10.3?}  speedup: x{:.2}",
+        name,
+        baseline,
+        preallocated,
+        baseline.as_secs_f64() / preallocated.as_secs_f64(),
+    );
+}
+
+fn main() {
+    let flat: Vec<FieldElement> = (0..1_000_000_u64).map(FieldElement::from).collect();
+    assert_eq!(
+        baseline_serialize(&flat),
+        Vec::<FieldElement>::cairo_serialize(&flat)
+    );
+
+    report(
+        "Vec<FieldElement> (1M)",
+        measure(|| baseline_serialize(&flat)),
+        measure(|| Vec::<FieldElement>::cairo_serialize(&flat)),
+    );
+
+    let nested: Vec<Vec<FieldElement>> = (0..10_000_u64)
+        .map(|i| (0..100_u64).map(|j| FieldElement::from(i * j)).collect())
+        .collect();
+    assert_eq!(
+        baseline_serialize_nested(&nested),
+        Vec::<Vec<FieldElement>>::cairo_serialize(&nested)
+    );
+
+    report(
+        "Vec<Vec<FieldElement>> (10k x 100)",
+        measure(|| baseline_serialize_nested(&nested)),
+        measure(|| Vec::<Vec<FieldElement>>::cairo_serialize(&nested)),
+    );
+}
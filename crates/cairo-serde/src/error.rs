@@ -15,15 +15,42 @@ pub enum Error {
     Serialize(String),
     #[error("Error during deserialization {0:?}.")]
     Deserialize(String),
+    #[error("Buffer too short to deserialize {type_name}: expected {expected} felts, got {got}.")]
+    BufferTooShort {
+        type_name: &'static str,
+        expected: usize,
+        got: usize,
+    },
+    #[error("Index {index:#x} at offset ({offset}) not handled for {type_name}.")]
+    UnknownDiscriminant {
+        type_name: &'static str,
+        index: FieldElement,
+        offset: usize,
+    },
+    #[error("Felt {value:#x} at offset ({offset}) doesn't fit into a {type_name}.")]
+    ValueOutOfRange {
+        type_name: &'static str,
+        value: FieldElement,
+        offset: usize,
+    },
+    #[error("Invalid offset ({offset}) to deserialize {type_name}.")]
+    InvalidOffset {
+        type_name: &'static str,
+        offset: usize,
+    },
     #[error("Provider errror {0:?}.")]
     Provider(#[from] ProviderError),
     #[error("Bytes31 out of range.")]
     Bytes31OutOfRange,
+    #[error("StorageAddress out of range.")]
+    StorageAddressOutOfRange,
 }
 
 impl CairoSerde for Error {
     type RustType = Self;
 
+    const SERIALIZED_SIZE: Option<usize> = Some(0);
+
     fn cairo_serialize(_rust: &Self::RustType) -> Vec<FieldElement> {
         vec![]
     }
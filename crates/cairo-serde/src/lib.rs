@@ -13,6 +13,7 @@ pub mod call;
 pub mod types;
 pub use types::array_legacy::*;
 pub use types::byte_array::*;
+pub use types::non_zero::*;
 pub use types::starknet::*;
 pub use types::*;
 
@@ -13,35 +13,41 @@ where
 
     #[inline]
     fn cairo_serialized_size(rust: &Self::RustType) -> usize {
-        let data = rust;
         // 1 + because the length is always the first felt.
-        1 + data.iter().map(T::cairo_serialized_size).sum::<usize>()
+        1 + rust.iter().map(T::cairo_serialized_size).sum::<usize>()
     }
 
     fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-        let mut out: Vec<FieldElement> = vec![rust.len().into()];
+        let mut out: Vec<FieldElement> = Vec::with_capacity(Self::cairo_serialized_size(rust));
+        out.push(rust.len().into());
         rust.iter().for_each(|r| out.extend(T::cairo_serialize(r)));
         out
     }
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an array: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "array",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         let len: usize = usize::from_str_radix(format!("{:x}", felts[offset]).as_str(), 16)
-            .map_err(|_| {
-                Error::Deserialize("First felt of an array must fit into usize".to_string())
+            .map_err(|_| Error::ValueOutOfRange {
+                type_name: "usize",
+                value: felts[offset],
+                offset,
             })?;
 
-        if offset + len >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an array of length {}: offset ({}) : buffer {:?}",
-                len, offset, felts,
-            )));
+        // Compared to the remaining felts to avoid overflowing with
+        // an arbitrary large length.
+        if len > felts.len() - offset - 1 {
+            return Err(Error::BufferTooShort {
+                type_name: "array",
+                expected: offset.saturating_add(1).saturating_add(len),
+                got: felts.len(),
+            });
         }
 
         let mut out: Vec<RT> = vec![];
@@ -64,6 +70,7 @@ where
 #[cfg(test)]
 mod tests {
     use super::*;
+    use crate::ContractAddress;
 
     #[test]
     fn test_serialize_array() {
@@ -120,6 +127,27 @@ mod tests {
         assert_eq!(vals[1], vec![3]);
     }
 
+    #[test]
+    fn test_deserialize_array_nested_empty() {
+        let felts: Vec<FieldElement> = vec![FieldElement::ZERO];
+        let vals = Vec::<Vec<u32>>::cairo_deserialize(&felts, 0).unwrap();
+        assert!(vals.is_empty());
+        assert_eq!(Vec::<Vec<u32>>::cairo_serialized_size(&vals), 1);
+
+        let felts: Vec<FieldElement> = vec![
+            FieldElement::THREE,
+            FieldElement::ZERO,
+            FieldElement::ONE,
+            FieldElement::TWO,
+            FieldElement::ZERO,
+        ];
+
+        let vals = Vec::<Vec<u32>>::cairo_deserialize(&felts, 0).unwrap();
+        assert_eq!(vals, vec![vec![], vec![2], vec![]]);
+        assert_eq!(Vec::<Vec<u32>>::cairo_serialized_size(&vals), felts.len());
+        assert_eq!(Vec::<Vec<u32>>::cairo_serialize(&vals), felts);
+    }
+
     #[test]
     fn test_serialize_array_tuple() {
         let v: Vec<(u32, FieldElement)> = vec![(12, FieldElement::TWO)];
@@ -142,4 +170,69 @@ mod tests {
         assert_eq!(vals.len(), 1);
         assert_eq!(vals[0], (12, FieldElement::TWO));
     }
+
+    #[test]
+    fn test_deserialize_array_tuple_multi_felts() {
+        // `(u128, u128)` has the same layout as a `u256` (low, high).
+        type Balance = (ContractAddress, (u128, u128));
+
+        let felts: Vec<FieldElement> = vec![FieldElement::ZERO];
+        let vals = Vec::<Balance>::cairo_deserialize(&felts, 0).unwrap();
+        assert!(vals.is_empty());
+
+        let felts: Vec<FieldElement> = vec![
+            FieldElement::TWO,
+            FieldElement::from(0x1111_u32),
+            FieldElement::ONE,
+            FieldElement::ZERO,
+            FieldElement::from(0x2222_u32),
+            FieldElement::TWO,
+            FieldElement::THREE,
+        ];
+
+        let vals = Vec::<Balance>::cairo_deserialize(&felts, 0).unwrap();
+        assert_eq!(
+            vals,
+            vec![
+                (ContractAddress(FieldElement::from(0x1111_u32)), (1, 0)),
+                (ContractAddress(FieldElement::from(0x2222_u32)), (2, 3)),
+            ]
+        );
+        assert_eq!(Vec::<Balance>::cairo_serialized_size(&vals), felts.len());
+        assert_eq!(Vec::<Balance>::cairo_serialize(&vals), felts);
+    }
+
+    #[test]
+    fn test_deserialize_array_invalid_len() {
+        let felts: Vec<FieldElement> = vec![FieldElement::from(u64::MAX), FieldElement::ONE];
+        assert!(Vec::<u32>::cairo_deserialize(&felts, 0).is_err());
+
+        let felts: Vec<FieldElement> = vec![FieldElement::MAX, FieldElement::ONE];
+        assert!(Vec::<u32>::cairo_deserialize(&felts, 0).is_err());
+
+        let felts: Vec<FieldElement> = vec![FieldElement::TWO, FieldElement::ONE];
+        assert!(Vec::<u32>::cairo_deserialize(&felts, 0).is_err());
+    }
+
+    #[test]
+    fn test_serialized_size_fixed_and_dynamic_elements() {
+        let v: Vec<u32> = vec![1, 2, 3];
+        assert_eq!(
+            Vec::<u32>::cairo_serialized_size(&v),
+            Vec::<u32>::cairo_serialize(&v).len()
+        );
+
+        let v: Vec<()> = vec![(), ()];
+        assert_eq!(Vec::<()>::cairo_serialized_size(&v), 1);
+        assert_eq!(Vec::<()>::cairo_serialize(&v).len(), 1);
+
+        let v: Vec<Option<u32>> = vec![Some(1), None, Some(3)];
+        assert_eq!(Vec::<Option<u32>>::cairo_serialized_size(&v), 6);
+        assert_eq!(Vec::<Option<u32>>::cairo_serialize(&v).len(), 6);
+        assert_eq!(
+            Vec::<Option<u32>>::cairo_deserialize(&Vec::<Option<u32>>::cairo_serialize(&v), 0)
+                .unwrap(),
+            v
+        );
+    }
 }
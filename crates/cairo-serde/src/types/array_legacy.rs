@@ -35,13 +35,12 @@ where
 
     #[inline]
     fn cairo_serialized_size(rust: &Self::RustType) -> usize {
-        let data = &rust.0;
         // In cairo 0, the length is always passed as an argument.
-        data.iter().map(T::cairo_serialized_size).sum::<usize>()
+        rust.0.iter().map(T::cairo_serialized_size).sum::<usize>()
     }
 
     fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-        let mut out: Vec<FieldElement> = vec![];
+        let mut out: Vec<FieldElement> = Vec::with_capacity(Self::cairo_serialized_size(rust));
         rust.0
             .iter()
             .for_each(|r| out.extend(T::cairo_serialize(r)));
@@ -49,26 +48,42 @@ where
     }
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
-        if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an array: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+        // The length is expected in the felt preceding the array.
+        if offset == 0 {
+            return Err(Error::InvalidOffset {
+                type_name: "CairoArrayLegacy",
+                offset,
+            });
         }
 
-        let mut out: Vec<RT> = vec![];
-        let mut offset = offset;
-        let len = felts[offset - 1];
+        if offset > felts.len() {
+            return Err(Error::BufferTooShort {
+                type_name: "CairoArrayLegacy",
+                expected: offset,
+                got: felts.len(),
+            });
+        }
 
-        if FieldElement::from(offset) + len >= FieldElement::from(felts.len()) {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an array of length {}: offset ({}) : buffer {:?}",
-                len, offset, felts,
-            )));
+        let len: usize = usize::from_str_radix(format!("{:x}", felts[offset - 1]).as_str(), 16)
+            .map_err(|_| Error::ValueOutOfRange {
+                type_name: "usize",
+                value: felts[offset - 1],
+                offset: offset - 1,
+            })?;
+
+        if len > felts.len() - offset {
+            return Err(Error::BufferTooShort {
+                type_name: "CairoArrayLegacy",
+                expected: offset.saturating_add(len),
+                got: felts.len(),
+            });
         }
 
+        let mut out: Vec<RT> = vec![];
+        let mut offset = offset;
+
         loop {
-            if FieldElement::from(out.len()) == len {
+            if out.len() == len {
                 break;
             }
 
@@ -80,3 +95,42 @@ where
         Ok(CairoArrayLegacy(out))
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_deserialize_array_legacy() {
+        let felts: Vec<FieldElement> = vec![
+            FieldElement::TWO,
+            FieldElement::from(123_u32),
+            FieldElement::from(9988_u32),
+        ];
+
+        let vals = CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 1).unwrap();
+        assert_eq!(vals, CairoArrayLegacy(vec![123_u32, 9988_u32]));
+
+        let felts: Vec<FieldElement> = vec![FieldElement::ZERO];
+        let vals = CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 1).unwrap();
+        assert!(vals.is_empty());
+    }
+
+    #[test]
+    fn test_deserialize_array_legacy_invalid_len() {
+        let felts: Vec<FieldElement> = vec![FieldElement::TWO, FieldElement::ONE];
+
+        // No felt before the array to read the length from.
+        assert!(matches!(
+            CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 0),
+            Err(Error::InvalidOffset {
+                type_name: "CairoArrayLegacy",
+                offset: 0,
+            })
+        ));
+        assert!(CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 1).is_err());
+
+        let felts: Vec<FieldElement> = vec![FieldElement::from(u64::MAX), FieldElement::ONE];
+        assert!(CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 1).is_err());
+    }
+}
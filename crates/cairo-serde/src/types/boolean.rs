@@ -11,16 +11,23 @@ impl CairoSerde for bool {
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize a boolean: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "bool",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
-        if felts[offset] == FieldElement::ONE {
+        if felts[offset] == FieldElement::ZERO {
+            Ok(false)
+        } else if felts[offset] == FieldElement::ONE {
             Ok(true)
         } else {
-            Ok(false)
+            Err(Error::UnknownDiscriminant {
+                type_name: "bool",
+                index: felts[offset],
+                offset,
+            })
         }
     }
 }
@@ -47,6 +54,6 @@ mod tests {
         let felts = vec![FieldElement::ZERO, FieldElement::ONE, FieldElement::TWO];
         assert!(!bool::cairo_deserialize(&felts, 0).unwrap());
         assert!(bool::cairo_deserialize(&felts, 1).unwrap());
-        assert!(!bool::cairo_deserialize(&felts, 2).unwrap());
+        assert!(bool::cairo_deserialize(&felts, 2).is_err());
     }
 }
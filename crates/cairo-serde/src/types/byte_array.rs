@@ -67,6 +67,14 @@ impl CairoSerde for Bytes31 {
     }
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> CainomeResult<Self::RustType> {
+        if offset >= felts.len() {
+            return Err(Error::BufferTooShort {
+                type_name: "Bytes31",
+                expected: offset + 1,
+                got: felts.len(),
+            });
+        }
+
         Self::new(felts[offset])
     }
 }
@@ -92,7 +100,7 @@ impl CairoSerde for ByteArray {
     }
 
     fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-        let mut out: Vec<FieldElement> = vec![];
+        let mut out: Vec<FieldElement> = Vec::with_capacity(Self::cairo_serialized_size(rust));
         out.extend(Vec::<Bytes31>::cairo_serialize(&rust.data));
         out.extend(FieldElement::cairo_serialize(&rust.pending_word));
         out.extend(u32::cairo_serialize(&(rust.pending_word_len as u32)));
@@ -105,12 +113,21 @@ impl CairoSerde for ByteArray {
         offset += Vec::<Bytes31>::cairo_serialized_size(&data);
         let pending_word = FieldElement::cairo_deserialize(felts, offset)?;
         offset += FieldElement::cairo_serialized_size(&pending_word);
-        let pending_word_len = u32::cairo_deserialize(felts, offset)?;
+        let pending_word_len = u32::cairo_deserialize(felts, offset)? as usize;
+
+        // The pending word is always shorter than a full word.
+        if pending_word_len >= MAX_WORD_LEN {
+            return Err(Error::ValueOutOfRange {
+                type_name: "ByteArray",
+                value: felts[offset],
+                offset,
+            });
+        }
 
         Ok(ByteArray {
             data,
             pending_word,
-            pending_word_len: pending_word_len as usize,
+            pending_word_len,
         })
     }
 }
@@ -223,7 +240,8 @@ impl TryFrom<&str> for ByteArray {
 
 #[cfg(test)]
 mod tests {
-    use super::ByteArray;
+    use super::{ByteArray, Bytes31};
+    use crate::{CairoSerde, Error};
     use starknet::core::types::FieldElement;
 
     #[test]
@@ -486,4 +504,96 @@ mod tests {
             }
         );
     }
+
+    #[test]
+    fn test_data_leading_zeros_roundtrip() {
+        // A full 31 bytes chunk starting with zero bytes must not be shortened.
+        let s = "\0\0ABCDEFGHIJKLMNOPQRSTUVWXYZ123XY";
+        let b = ByteArray::from_string(s).unwrap();
+
+        assert_eq!(
+            b.data[0].felt(),
+            FieldElement::from_hex_be(
+                "0x0000004142434445464748494a4b4c4d4e4f505152535455565758595a313233"
+            )
+            .unwrap()
+        );
+
+        let felts = ByteArray::cairo_serialize(&b);
+        let b2 = ByteArray::cairo_deserialize(&felts, 0).unwrap();
+
+        assert_eq!(b2, b);
+        assert_eq!(b2.to_string().unwrap(), s);
+    }
+
+    #[test]
+    fn test_array_of_byte_arrays_roundtrip() {
+        let strings = ["ABCDEFGHIJKLMNOPQRSTUVWXYZ12345ABCD", ""];
+        let v: Vec<ByteArray> = strings
+            .iter()
+            .map(|s| ByteArray::from_string(s).unwrap())
+            .collect();
+
+        let felts = Vec::<ByteArray>::cairo_serialize(&v);
+        // Array length, then for each ByteArray: data length, data,
+        // pending word and pending word length.
+        assert_eq!(felts.len(), 1 + 4 + 3);
+        assert_eq!(felts[0], FieldElement::TWO);
+
+        let v2 = Vec::<ByteArray>::cairo_deserialize(&felts, 0).unwrap();
+        assert_eq!(Vec::<ByteArray>::cairo_serialized_size(&v2), felts.len());
+        assert_eq!(
+            v2.iter()
+                .map(|b| b.to_string().unwrap())
+                .collect::<Vec<_>>(),
+            strings
+        );
+    }
+
+    #[test]
+    fn test_deserialize_bytes31_buffer_too_short() {
+        assert!(Bytes31::cairo_deserialize(&[], 0).is_err());
+        assert!(Bytes31::cairo_deserialize(&[FieldElement::ONE], 1).is_err());
+    }
+
+    #[test]
+    fn test_deserialize_byte_array_buffer_too_short() {
+        let felts = ByteArray::cairo_serialize(&ByteArray::from_string("ABCD").unwrap());
+
+        for len in 0..felts.len() {
+            assert!(ByteArray::cairo_deserialize(&felts[..len], 0).is_err());
+        }
+    }
+
+    #[test]
+    fn test_deserialize_byte_array_corrupted_chunk_count() {
+        for chunk_count in [FieldElement::from(u64::MAX), FieldElement::MAX] {
+            let felts = vec![
+                chunk_count,
+                FieldElement::ONE,
+                FieldElement::from_hex_be("0x41").unwrap(),
+                FieldElement::ONE,
+            ];
+
+            assert!(ByteArray::cairo_deserialize(&felts, 0).is_err());
+        }
+    }
+
+    #[test]
+    fn test_deserialize_byte_array_invalid_pending_word_len() {
+        let felts = vec![
+            FieldElement::ZERO,
+            FieldElement::from_hex_be("0x41").unwrap(),
+            FieldElement::from(31_u32),
+        ];
+
+        assert!(matches!(
+            ByteArray::cairo_deserialize(&felts, 0),
+            Err(Error::ValueOutOfRange {
+                type_name: "ByteArray",
+                offset: 2,
+                ..
+            })
+        ));
+    }
 }
@@ -10,10 +10,11 @@ impl CairoSerde for FieldElement {
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize a felt: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "felt252",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         Ok(felts[offset])
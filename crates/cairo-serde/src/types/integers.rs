@@ -13,15 +13,21 @@ macro_rules! implement_trait_for_unsigned {
 
             fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
                 if offset >= felts.len() {
-                    return Err(Error::Deserialize(format!(
-                        "Buffer too short to deserialize a unsigned integer: offset ({}) : buffer {:?}",
-                        offset,
-                        felts,
-                    )));
+                    return Err(Error::BufferTooShort {
+                        type_name: stringify!($type),
+                        expected: offset + 1,
+                        got: felts.len(),
+                    });
                 }
 
-                let temp: u128 = felts[offset].try_into().unwrap();
-                Ok(temp as $type)
+                let out_of_range = || Error::ValueOutOfRange {
+                    type_name: stringify!($type),
+                    value: felts[offset],
+                    offset,
+                };
+
+                let temp: u128 = felts[offset].try_into().map_err(|_| out_of_range())?;
+                <$type>::try_from(temp).map_err(|_| out_of_range())
             }
         }
     };
@@ -38,15 +44,27 @@ macro_rules! implement_trait_for_signed {
 
             fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
                 if offset >= felts.len() {
-                    return Err(Error::Deserialize(format!(
-                        "Buffer too short to deserialize a signed integer: offset ({}) : buffer {:?}",
-                        offset,
-                        felts,
-                    )));
+                    return Err(Error::BufferTooShort {
+                        type_name: stringify!($type),
+                        expected: offset + 1,
+                        got: felts.len(),
+                    });
+                }
+
+                let out_of_range = || Error::ValueOutOfRange {
+                    type_name: stringify!($type),
+                    value: felts[offset],
+                    offset,
+                };
+
+                let temp: u128 = felts[offset].try_into().map_err(|_| out_of_range())?;
+                if let Ok(v) = <$type>::try_from(temp) {
+                    return Ok(v);
                 }
 
-                let temp: u128 = felts[offset].try_into().unwrap();
-                Ok(temp as $type)
+                // Negative values are serialized sign-extended to an usize.
+                let temp = usize::try_from(temp).map_err(|_| out_of_range())? as isize;
+                <$type>::try_from(temp).map_err(|_| out_of_range())
             }
         }
     };
@@ -84,6 +102,12 @@ mod tests {
         assert_eq!(u8::cairo_deserialize(&felts, 1).unwrap(), 10);
     }
 
+    #[test]
+    fn test_deserialize_u8_overflow() {
+        let felts = vec![FieldElement::from(u8::MAX as u16 + 1)];
+        assert!(u8::cairo_deserialize(&felts, 0).is_err());
+    }
+
     #[test]
     fn test_serialize_u16() {
         let v = 12_u16;
@@ -129,6 +153,16 @@ mod tests {
         assert_eq!(u64::cairo_deserialize(&felts, 1).unwrap(), 99);
     }
 
+    #[test]
+    fn test_deserialize_u64_overflow() {
+        let felts = vec![
+            FieldElement::from(u64::MAX),
+            FieldElement::from(u64::MAX as u128 + 1),
+        ];
+        assert_eq!(u64::cairo_deserialize(&felts, 0).unwrap(), u64::MAX);
+        assert!(u64::cairo_deserialize(&felts, 1).is_err());
+    }
+
     #[test]
     fn test_serialize_u128() {
         let v = 123_u128;
@@ -144,6 +178,12 @@ mod tests {
         assert_eq!(u128::cairo_deserialize(&felts, 1).unwrap(), 99);
     }
 
+    #[test]
+    fn test_deserialize_u128_overflow() {
+        let felts = vec![FieldElement::from(u128::MAX) + FieldElement::ONE];
+        assert!(u128::cairo_deserialize(&felts, 0).is_err());
+    }
+
     #[test]
     fn test_serialize_usize() {
         let v = 123;
@@ -240,4 +280,50 @@ mod tests {
         assert_eq!(i128::cairo_deserialize(&felts, 0).unwrap(), i128::MAX);
         assert_eq!(i128::cairo_deserialize(&felts, 1).unwrap(), i128::MAX);
     }
+
+    #[test]
+    fn test_serialize_deserialize_negative() {
+        let felts = i8::cairo_serialize(&-12_i8);
+        assert_eq!(felts[0], FieldElement::from(-12_i8 as usize));
+        assert_eq!(i8::cairo_deserialize(&felts, 0).unwrap(), -12);
+
+        let felts = i64::cairo_serialize(&i64::MIN);
+        assert_eq!(i64::cairo_deserialize(&felts, 0).unwrap(), i64::MIN);
+    }
+
+    #[test]
+    fn test_deserialize_i64_sign_extended() {
+        let felts = vec![FieldElement::from(u64::MAX)];
+        assert_eq!(i64::cairo_deserialize(&felts, 0).unwrap(), -1);
+    }
+
+    #[test]
+    fn test_deserialize_i64_overflow() {
+        let felts = vec![FieldElement::from(u64::MAX as u128 + 1)];
+        assert!(matches!(
+            i64::cairo_deserialize(&felts, 0),
+            Err(Error::ValueOutOfRange {
+                type_name: "i64",
+                offset: 0,
+                ..
+            })
+        ));
+    }
+
+    #[test]
+    fn test_deserialize_i8_overflow() {
+        let felts = vec![FieldElement::from(300_u16)];
+        assert!(matches!(
+            i8::cairo_deserialize(&felts, 0),
+            Err(Error::ValueOutOfRange {
+                type_name: "i8",
+                offset: 0,
+                ..
+            })
+        ));
+
+        // Sign-extended from a width larger than i8.
+        let felts = vec![FieldElement::from(i16::MIN as usize)];
+        assert!(i8::cairo_deserialize(&felts, 0).is_err());
+    }
 }
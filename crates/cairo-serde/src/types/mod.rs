@@ -4,6 +4,7 @@ pub mod boolean;
 pub mod byte_array;
 pub mod felt;
 pub mod integers;
+pub mod non_zero;
 pub mod option;
 pub mod result;
 pub mod starknet;
@@ -11,7 +12,10 @@ pub mod tuple;
 
 #[cfg(test)]
 mod tests {
-    use crate::CairoSerde;
+    use crate::{
+        ByteArray, Bytes31, CairoArrayLegacy, CairoSerde, ClassHash, ContractAddress, Error,
+        EthAddress, NonZero, StorageAddress,
+    };
     use ::starknet::core::types::FieldElement;
 
     #[test]
@@ -115,4 +119,217 @@ mod tests {
         assert_eq!(a, vec![1, 2, 3]);
         assert_eq!(offset, felts.len());
     }
+
+    #[test]
+    fn test_deserialize_buffer_too_short() {
+        macro_rules! assert_too_short {
+            ($($ty:ty),+) => {
+                $(
+                    assert!(
+                        matches!(
+                            <$ty>::cairo_deserialize(&[FieldElement::ONE], 1),
+                            Err(Error::BufferTooShort { expected: 2, got: 1, .. })
+                        ),
+                        "{}",
+                        stringify!($ty)
+                    );
+                )+
+            };
+        }
+
+        assert_too_short!(
+            FieldElement,
+            bool,
+            u8,
+            u128,
+            i64,
+            Bytes31,
+            ByteArray,
+            ContractAddress,
+            ClassHash,
+            EthAddress,
+            StorageAddress,
+            NonZero<u32>,
+            Vec<u32>,
+            Option<u32>,
+            Result<u32, u32>,
+            (u32, u32)
+        );
+
+        let felts = vec![FieldElement::THREE, FieldElement::ONE];
+        assert!(matches!(
+            Vec::<u32>::cairo_deserialize(&felts, 0),
+            Err(Error::BufferTooShort {
+                expected: 4,
+                got: 2,
+                ..
+            })
+        ));
+        assert!(matches!(
+            CairoArrayLegacy::<u32>::cairo_deserialize(&felts, 1),
+            Err(Error::BufferTooShort {
+                expected: 4,
+                got: 2,
+                ..
+            })
+        ));
+
+        assert_eq!(
+            Vec::<u32>::cairo_deserialize(&felts, 0)
+                .unwrap_err()
+                .to_string(),
+            "Buffer too short to deserialize array: expected 4 felts, got 2."
+        );
+    }
+
+    #[test]
+    fn test_deserialize_unknown_discriminant() {
+        let felts = vec![FieldElement::ONE, FieldElement::TWO];
+
+        assert!(matches!(
+            bool::cairo_deserialize(&felts, 1),
+            Err(Error::UnknownDiscriminant { type_name: "bool", index, offset: 1 })
+                if index == FieldElement::TWO
+        ));
+        assert!(matches!(
+            Option::<u32>::cairo_deserialize(&felts, 1),
+            Err(Error::UnknownDiscriminant {
+                type_name: "Option",
+                ..
+            })
+        ));
+        assert!(matches!(
+            Result::<u32, u32>::cairo_deserialize(&felts, 1),
+            Err(Error::UnknownDiscriminant {
+                type_name: "Result",
+                ..
+            })
+        ));
+
+        assert_eq!(
+            bool::cairo_deserialize(&felts, 1).unwrap_err().to_string(),
+            "Index 0x2 at offset (1) not handled for bool."
+        );
+    }
+
+    #[test]
+    fn test_deserialize_value_out_of_range() {
+        let felts = vec![FieldElement::from(256_u32), FieldElement::MAX];
+
+        assert!(matches!(
+            u8::cairo_deserialize(&felts, 0),
+            Err(Error::ValueOutOfRange { type_name: "u8", value, offset: 0 })
+                if value == FieldElement::from(256_u32)
+        ));
+        assert!(matches!(
+            i64::cairo_deserialize(&felts, 1),
+            Err(Error::ValueOutOfRange {
+                type_name: "i64",
+                offset: 1,
+                ..
+            })
+        ));
+        assert!(matches!(
+            Vec::<u32>::cairo_deserialize(&felts, 1),
+            Err(Error::ValueOutOfRange {
+                type_name: "usize",
+                offset: 1,
+                ..
+            })
+        ));
+
+        let felts = vec![
+            FieldElement::ZERO,
+            FieldElement::ONE,
+            FieldElement::from(31_u32),
+        ];
+        assert!(matches!(
+            ByteArray::cairo_deserialize(&felts, 0),
+            Err(Error::ValueOutOfRange { type_name: "ByteArray", value, offset: 2 })
+                if value == FieldElement::from(31_u32)
+        ));
+
+        let felts = vec![FieldElement::ONE, FieldElement::ZERO];
+        assert!(matches!(
+            NonZero::<u32>::cairo_deserialize(&felts, 1),
+            Err(Error::ValueOutOfRange { type_name: "NonZero", value, offset: 1 })
+                if value == FieldElement::ZERO
+        ));
+
+        assert_eq!(
+            u8::cairo_deserialize(&[FieldElement::from(256_u32)], 0)
+                .unwrap_err()
+                .to_string(),
+            "Felt 0x100 at offset (0) doesn't fit into a u8."
+        );
+    }
+
+    /// Generates felts of various magnitudes from a xorshift seed,
+    /// to have deterministic pseudo-random buffers.
+    fn garbage_felts(seed: &mut u64, len: usize) -> Vec<FieldElement> {
+        (0..len)
+            .map(|_| {
+                *seed ^= *seed << 13;
+                *seed ^= *seed >> 7;
+                *seed ^= *seed << 17;
+
+                match *seed % 6 {
+                    0 => FieldElement::ZERO,
+                    1 => FieldElement::from(*seed % 4),
+                    2 => FieldElement::from(*seed % 64),
+                    3 => FieldElement::from(*seed),
+                    4 => FieldElement::MAX,
+                    _ => {
+                        let mut bytes = [0_u8; 32];
+                        for (i, b) in bytes.iter_mut().enumerate() {
+                            *b = seed.rotate_left(i as u32 * 8) as u8;
+                        }
+                        bytes[0] &= 0x07;
+                        FieldElement::from_bytes_be(&bytes).unwrap_or(FieldElement::MAX)
+                    }
+                }
+            })
+            .collect()
+    }
+
+    fn deserialize_all(felts: &[FieldElement], offset: usize) {
+        // Only the absence of panic is checked here.
+        let _ = FieldElement::cairo_deserialize(felts, offset);
+        let _ = bool::cairo_deserialize(felts, offset);
+        let _ = u8::cairo_deserialize(felts, offset);
+        let _ = u128::cairo_deserialize(felts, offset);
+        let _ = usize::cairo_deserialize(felts, offset);
+        let _ = i8::cairo_deserialize(felts, offset);
+        let _ = i128::cairo_deserialize(felts, offset);
+        let _ = Bytes31::cairo_deserialize(felts, offset);
+        let _ = ByteArray::cairo_deserialize(felts, offset);
+        let _ = ContractAddress::cairo_deserialize(felts, offset);
+        let _ = ClassHash::cairo_deserialize(felts, offset);
+        let _ = EthAddress::cairo_deserialize(felts, offset);
+        let _ = StorageAddress::cairo_deserialize(felts, offset);
+        let _ = NonZero::<u64>::cairo_deserialize(felts, offset);
+        let _ = Vec::<u32>::cairo_deserialize(felts, offset);
+        let _ = Vec::<Vec<FieldElement>>::cairo_deserialize(felts, offset);
+        let _ = Vec::<ByteArray>::cairo_deserialize(felts, offset);
+        let _ = CairoArrayLegacy::<u32>::cairo_deserialize(felts, offset);
+        let _ = Option::<u64>::cairo_deserialize(felts, offset);
+        let _ = Option::<Vec<u8>>::cairo_deserialize(felts, offset);
+        let _ = Result::<u8, ByteArray>::cairo_deserialize(felts, offset);
+        let _ = <(bool, Vec<u16>, Option<u8>)>::cairo_deserialize(felts, offset);
+    }
+
+    #[test]
+    fn test_deserialize_garbage_does_not_panic() {
+        let mut seed = 0x2545_f491_4f6c_dd1d_u64;
+
+        for _ in 0..500 {
+            for len in 0..8 {
+                let felts = garbage_felts(&mut seed, len);
+
+                for offset in 0..=len + 1 {
+                    deserialize_all(&felts, offset);
+                }
+            }
+        }
+    }
 }
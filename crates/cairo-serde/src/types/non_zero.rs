@@ -0,0 +1,114 @@
+//! CairoSerde implementation for `NonZero`.
+//!
+//! `NonZero<T>` is serialized exactly as `T`, the only difference
+//! being the guarantee that the value is not zero.
+//!
+//! https://github.com/starkware-libs/cairo/blob/main/corelib/src/zeroable.cairo
+use crate::{CairoSerde, Error, Result};
+use starknet::core::types::FieldElement;
+
+/// A value that is guaranteed to be different from zero.
+/// A value is considered zero if all its serialized felts are zero.
+#[derive(Debug, Copy, Clone, PartialEq)]
+pub struct NonZero<T>(T);
+
+impl<T: CairoSerde<RustType = T>> NonZero<T> {
+    /// Wraps the given value, returning `None` if the value is zero.
+    pub fn new(value: T) -> Option<Self> {
+        if is_zero(&T::cairo_serialize(&value)) {
+            None
+        } else {
+            Some(Self(value))
+        }
+    }
+}
+
+impl<T> NonZero<T> {
+    pub fn inner(&self) -> &T {
+        &self.0
+    }
+
+    pub fn into_inner(self) -> T {
+        self.0
+    }
+}
+
+impl<T, RT> CairoSerde for NonZero<T>
+where
+    T: CairoSerde<RustType = RT>,
+{
+    type RustType = NonZero<RT>;
+
+    const SERIALIZED_SIZE: Option<usize> = T::SERIALIZED_SIZE;
+
+    #[inline]
+    fn cairo_serialized_size(rust: &Self::RustType) -> usize {
+        T::cairo_serialized_size(&rust.0)
+    }
+
+    fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
+        T::cairo_serialize(&rust.0)
+    }
+
+    fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
+        let value = T::cairo_deserialize(felts, offset)?;
+        let size = T::cairo_serialized_size(&value);
+
+        if is_zero(&felts[offset..offset + size]) {
+            return Err(Error::ValueOutOfRange {
+                type_name: "NonZero",
+                value: felts[offset],
+                offset,
+            });
+        }
+
+        Ok(NonZero(value))
+    }
+}
+
+fn is_zero(felts: &[FieldElement]) -> bool {
+    felts.iter().all(|f| *f == FieldElement::ZERO)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_non_zero_new() {
+        assert!(NonZero::new(0_u32).is_none());
+        assert_eq!(NonZero::new(12_u32).unwrap().into_inner(), 12_u32);
+        assert!(NonZero::new((0_u128, 0_u128)).is_none());
+        assert!(NonZero::new((0_u128, 1_u128)).is_some());
+    }
+
+    #[test]
+    fn test_non_zero_cairo_serialize() {
+        let v = NonZero::new(FieldElement::TWO).unwrap();
+        let felts = NonZero::<FieldElement>::cairo_serialize(&v);
+        assert_eq!(felts.len(), 1);
+        assert_eq!(felts[0], FieldElement::TWO);
+    }
+
+    #[test]
+    fn test_non_zero_cairo_deserialize() {
+        let felts = vec![FieldElement::ZERO, FieldElement::THREE];
+        let v = NonZero::<u64>::cairo_deserialize(&felts, 1).unwrap();
+        assert_eq!(*v.inner(), 3_u64);
+        assert_eq!(NonZero::<u64>::cairo_serialized_size(&v), 1);
+    }
+
+    #[test]
+    fn test_non_zero_cairo_deserialize_zero() {
+        let felts = vec![FieldElement::ZERO, FieldElement::ZERO];
+        assert!(matches!(
+            NonZero::<u64>::cairo_deserialize(&felts, 1),
+            Err(Error::ValueOutOfRange {
+                type_name: "NonZero",
+                offset: 1,
+                ..
+            })
+        ));
+        assert!(NonZero::<(u128, u128)>::cairo_deserialize(&felts, 0).is_err());
+    }
+}
@@ -13,6 +13,8 @@ where
 {
     type RustType = Option<RT>;
 
+    const SERIALIZED_SIZE: Option<usize> = None;
+
     #[inline]
     fn cairo_serialized_size(rust: &Self::RustType) -> usize {
         match rust {
@@ -22,7 +24,7 @@ where
     }
 
     fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-        let mut out = vec![];
+        let mut out = Vec::with_capacity(Self::cairo_serialized_size(rust));
 
         match rust {
             Some(r) => {
@@ -37,10 +39,11 @@ where
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an Option: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "Option",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         let idx = felts[offset];
@@ -51,9 +54,11 @@ where
         } else if idx == FieldElement::ONE {
             Ok(Option::None)
         } else {
-            Err(Error::Deserialize(
-                "Option is expected 0 or 1 index only".to_string(),
-            ))
+            Err(Error::UnknownDiscriminant {
+                type_name: "Option",
+                index: idx,
+                offset,
+            })
         }
     }
 }
@@ -153,4 +158,33 @@ mod tests {
         let o = Option::<u32>::cairo_deserialize(&felts, 1).unwrap();
         assert_eq!(o, None);
     }
+
+    #[test]
+    fn test_option_result_cairo_roundtrip() {
+        type T = Option<core::result::Result<u32, FieldElement>>;
+
+        let values: Vec<T> = vec![Some(Ok(u32::MAX)), Some(Err(FieldElement::TWO)), None];
+        let expected = vec![
+            vec![
+                FieldElement::ZERO,
+                FieldElement::ZERO,
+                FieldElement::from(u32::MAX),
+            ],
+            vec![FieldElement::ZERO, FieldElement::ONE, FieldElement::TWO],
+            vec![FieldElement::ONE],
+        ];
+
+        for (v, e) in values.iter().zip(expected) {
+            let mut felts = T::cairo_serialize(v);
+            assert_eq!(felts, e);
+
+            // A trailing value ensures the consumed size is correct.
+            felts.push(FieldElement::THREE);
+
+            let o = T::cairo_deserialize(&felts, 0).unwrap();
+            let offset = T::cairo_serialized_size(&o);
+            assert_eq!(&o, v);
+            assert_eq!(u32::cairo_deserialize(&felts, offset).unwrap(), 3);
+        }
+    }
 }
@@ -11,6 +11,8 @@ where
 {
     type RustType = Result<RT, RE>;
 
+    const SERIALIZED_SIZE: Option<usize> = None;
+
     #[inline]
     fn cairo_serialized_size(rust: &Self::RustType) -> usize {
         match rust {
@@ -20,7 +22,7 @@ where
     }
 
     fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-        let mut out = vec![];
+        let mut out = Vec::with_capacity(Self::cairo_serialized_size(rust));
 
         match rust {
             Result::Ok(r) => {
@@ -38,10 +40,11 @@ where
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> CairoResult<Self::RustType> {
         if offset >= felts.len() {
-            return Err(CairoError::Deserialize(format!(
-                "Buffer too short to deserialize a Result: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(CairoError::BufferTooShort {
+                type_name: "Result",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         let idx = felts[offset];
@@ -52,9 +55,11 @@ where
         } else if idx == FieldElement::ONE {
             CairoResult::Ok(Err(E::cairo_deserialize(felts, offset + 1)?))
         } else {
-            Err(CairoError::Deserialize(
-                "Result is expected 0 or 1 index only".to_string(),
-            ))
+            Err(CairoError::UnknownDiscriminant {
+                type_name: "Result",
+                index: idx,
+                offset,
+            })
         }
     }
 }
@@ -110,4 +115,33 @@ mod tests {
         let r = Result::<FieldElement, FieldElement>::cairo_deserialize(&felts, 0).unwrap();
         assert_eq!(r, Err(FieldElement::ONE));
     }
+
+    #[test]
+    fn test_result_option_cairo_roundtrip() {
+        type T = Result<Option<u32>, FieldElement>;
+
+        let values: Vec<T> = vec![Ok(Some(u32::MAX)), Ok(None), Err(FieldElement::TWO)];
+        let expected = vec![
+            vec![
+                FieldElement::ZERO,
+                FieldElement::ZERO,
+                FieldElement::from(u32::MAX),
+            ],
+            vec![FieldElement::ZERO, FieldElement::ONE],
+            vec![FieldElement::ONE, FieldElement::TWO],
+        ];
+
+        for (v, e) in values.iter().zip(expected) {
+            let mut felts = T::cairo_serialize(v);
+            assert_eq!(felts, e);
+
+            // A trailing value ensures the consumed size is correct.
+            felts.push(FieldElement::THREE);
+
+            let r = T::cairo_deserialize(&felts, 0).unwrap();
+            let offset = T::cairo_serialized_size(&r);
+            assert_eq!(&r, v);
+            assert_eq!(u32::cairo_deserialize(&felts, offset).unwrap(), 3);
+        }
+    }
 }
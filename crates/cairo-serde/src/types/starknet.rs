@@ -29,10 +29,11 @@ impl CairoSerde for ContractAddress {
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize a ContractAddress: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "ContractAddress",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         Ok(ContractAddress(FieldElement::cairo_deserialize(
@@ -66,10 +67,11 @@ impl CairoSerde for ClassHash {
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize a ClassHash: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "ClassHash",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         Ok(ClassHash(FieldElement::cairo_deserialize(felts, offset)?))
@@ -101,16 +103,81 @@ impl CairoSerde for EthAddress {
 
     fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
         if offset >= felts.len() {
-            return Err(Error::Deserialize(format!(
-                "Buffer too short to deserialize an EthAddress: offset ({}) : buffer {:?}",
-                offset, felts,
-            )));
+            return Err(Error::BufferTooShort {
+                type_name: "EthAddress",
+                expected: offset + 1,
+                got: felts.len(),
+            });
         }
 
         Ok(EthAddress(FieldElement::cairo_deserialize(felts, offset)?))
     }
 }
 
+/// Storage addresses are at most `2**251 - 1`
+/// (`0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff`).
+pub const STORAGE_ADDRESS_MAX: FieldElement = FieldElement::from_mont([
+    18446743986131435585,
+    160989183,
+    18446744073709255680,
+    576459263475451504,
+]);
+
+/// StorageAddress.
+///
+/// Unlike other starknet types, the felt is range checked
+/// as a storage address must be lower than `2**251`.
+#[derive(Debug, Copy, Clone, PartialEq)]
+pub struct StorageAddress(FieldElement);
+
+impl StorageAddress {
+    pub fn new(felt: FieldElement) -> Result<Self> {
+        if felt > STORAGE_ADDRESS_MAX {
+            Err(Error::StorageAddressOutOfRange)
+        } else {
+            Ok(Self(felt))
+        }
+    }
+
+    pub fn felt(&self) -> FieldElement {
+        self.0
+    }
+}
+
+impl TryFrom<FieldElement> for StorageAddress {
+    type Error = Error;
+
+    fn try_from(item: FieldElement) -> Result<Self> {
+        Self::new(item)
+    }
+}
+
+impl From<StorageAddress> for FieldElement {
+    fn from(item: StorageAddress) -> Self {
+        item.0
+    }
+}
+
+impl CairoSerde for StorageAddress {
+    type RustType = Self;
+
+    fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
+        FieldElement::cairo_serialize(&rust.0)
+    }
+
+    fn cairo_deserialize(felts: &[FieldElement], offset: usize) -> Result<Self::RustType> {
+        if offset >= felts.len() {
+            return Err(Error::BufferTooShort {
+                type_name: "StorageAddress",
+                expected: offset + 1,
+                got: felts.len(),
+            });
+        }
+
+        StorageAddress::new(FieldElement::cairo_deserialize(felts, offset)?)
+    }
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -160,6 +227,46 @@ mod tests {
         assert_eq!(eth_address, EthAddress(FieldElement::from(1_u32)))
     }
 
+    #[test]
+    fn test_storage_address_cairo_serialize() {
+        let storage_address = StorageAddress::new(FieldElement::from(1_u32)).unwrap();
+        let felts = StorageAddress::cairo_serialize(&storage_address);
+        assert_eq!(felts.len(), 1);
+        assert_eq!(felts[0], FieldElement::from(1_u32));
+    }
+
+    #[test]
+    fn test_storage_address_cairo_deserialize() {
+        let felts = vec![FieldElement::from(1_u32), STORAGE_ADDRESS_MAX];
+        let storage_address = StorageAddress::cairo_deserialize(&felts, 0).unwrap();
+        assert_eq!(storage_address.felt(), FieldElement::from(1_u32));
+
+        let storage_address = StorageAddress::cairo_deserialize(&felts, 1).unwrap();
+        assert_eq!(storage_address.felt(), STORAGE_ADDRESS_MAX);
+    }
+
+    #[test]
+    fn test_storage_address_max() {
+        let max = FieldElement::from_hex_be(
+            "0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+        )
+        .unwrap();
+        assert_eq!(STORAGE_ADDRESS_MAX, max);
+
+        let two_pow_251 = FieldElement::from_hex_be(
+            "0x800000000000000000000000000000000000000000000000000000000000000",
+        )
+        .unwrap();
+        assert!(StorageAddress::new(two_pow_251).is_err());
+    }
+
+    #[test]
+    fn test_storage_address_out_of_range() {
+        let felt = STORAGE_ADDRESS_MAX + FieldElement::ONE;
+        assert!(StorageAddress::new(felt).is_err());
+        assert!(StorageAddress::cairo_deserialize(&[felt], 0).is_err());
+    }
+
     #[test]
     fn test_contract_address_from() {
         let contract_address = ContractAddress::from(FieldElement::from(1_u32));
@@ -5,6 +5,8 @@ use starknet::core::types::FieldElement;
 impl CairoSerde for () {
     type RustType = Self;
 
+    const SERIALIZED_SIZE: Option<usize> = Some(0);
+
     #[inline]
     fn cairo_serialized_size(_rust: &Self::RustType) -> usize {
         0
@@ -40,7 +42,8 @@ macro_rules! impl_tuples {
             }
 
             fn cairo_serialize(rust: &Self::RustType) -> Vec<FieldElement> {
-                let mut out: Vec<FieldElement> = vec![];
+                let mut out: Vec<FieldElement> =
+                    Vec::with_capacity(Self::cairo_serialized_size(rust));
 
                 $( out.extend($ty::cairo_serialize(& rust.$no)); )*
 
@@ -64,10 +67,18 @@ macro_rules! impl_tuples {
     }
 }
 
+// Tuples are supported up to 12 elements, as the standard library does for its traits.
 impl_tuples!(2, A:RA:r0:0, B:RB:r1:1);
 impl_tuples!(3, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2);
 impl_tuples!(4, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3);
 impl_tuples!(5, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4);
+impl_tuples!(6, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5);
+impl_tuples!(7, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6);
+impl_tuples!(8, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6, H:RH:r7:7);
+impl_tuples!(9, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6, H:RH:r7:7, I:RI:r8:8);
+impl_tuples!(10, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6, H:RH:r7:7, I:RI:r8:8, J:RJ:r9:9);
+impl_tuples!(11, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6, H:RH:r7:7, I:RI:r8:8, J:RJ:r9:9, K:RK:r10:10);
+impl_tuples!(12, A:RA:r0:0, B:RB:r1:1, C:RC:r2:2, D:RD:r3:3, E:RE:r4:4, F:RF:r5:5, G:RG:r6:6, H:RH:r7:7, I:RI:r8:8, J:RJ:r9:9, K:RK:r10:10, L:RL:r11:11);
 
 #[cfg(test)]
 mod tests {
@@ -107,4 +118,33 @@ mod tests {
         assert_eq!(vals.0, vec![FieldElement::ONE]);
         assert_eq!(vals.1, 99_u32);
     }
+
+    #[test]
+    fn test_tuple5_heterogeneous_roundtrip() {
+        type T = (bool, u8, Vec<u32>, Option<FieldElement>, i64);
+        let v: T = (true, 7, vec![1, 2], Some(FieldElement::TWO), -3);
+
+        let felts = T::cairo_serialize(&v);
+        assert_eq!(felts.len(), 8);
+        assert_eq!(T::cairo_serialized_size(&v), 8);
+        assert_eq!(felts[0], FieldElement::ONE);
+        assert_eq!(felts[1], FieldElement::from(7_u8));
+        assert_eq!(felts[2], FieldElement::TWO);
+        assert_eq!(felts[5], FieldElement::ZERO);
+        assert_eq!(felts[6], FieldElement::TWO);
+
+        let vals = T::cairo_deserialize(&felts, 0).unwrap();
+        assert_eq!(vals, v);
+    }
+
+    #[test]
+    fn test_tuple12_roundtrip() {
+        type T = (u8, u8, u8, u8, u8, u8, u8, u8, u8, u8, u8, u8);
+        let v: T = (0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11);
+
+        let felts = T::cairo_serialize(&v);
+        assert_eq!(felts.len(), 12);
+        assert_eq!(felts[11], FieldElement::from(11_u8));
+        assert_eq!(T::cairo_deserialize(&felts, 0).unwrap(), v);
+    }
 }
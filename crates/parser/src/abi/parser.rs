@@ -1,7 +1,9 @@
 use starknet::core::types::contract::{AbiEntry, AbiEvent, TypedAbiEvent};
 use std::collections::HashMap;
 
-use crate::tokens::{Array, CompositeInner, CompositeType, CoreBasic, Function, Token};
+use crate::tokens::{
+    Array, CompositeInner, CompositeType, CoreBasic, Function, StateMutability, Token,
+};
 use crate::CainomeResult;
 
 pub struct AbiParser {}
@@ -58,6 +60,31 @@ impl AbiParser {
         Ok(tokens)
     }
 
+    /// Parses the constructor of the contract, if any.
+    /// Type aliases are applied to the constructor inputs.
+    pub fn collect_constructor(
+        entries: &[AbiEntry],
+        type_aliases: &HashMap<String, String>,
+    ) -> CainomeResult<Option<Function>> {
+        for entry in entries {
+            if let AbiEntry::Constructor(c) = entry {
+                let mut func = Function::new(&c.name, StateMutability::External);
+
+                for i in &c.inputs {
+                    func.inputs.push((i.name.clone(), Token::parse(&i.r#type)?));
+                }
+
+                for (type_path, alias) in type_aliases {
+                    func.apply_alias(type_path, alias);
+                }
+
+                return Ok(Some(func));
+            }
+        }
+
+        Ok(None)
+    }
+
     ///
     fn collect_entry_function(
         entry: &AbiEntry,
@@ -238,3 +265,81 @@ impl AbiParser {
         tokens_filtered
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use starknet::core::types::contract::{
+        AbiConstructor, AbiFunction, AbiNamedMember, StateMutability as StarknetStateMutability,
+    };
+
+    fn named_member(name: &str, r#type: &str) -> AbiNamedMember {
+        AbiNamedMember {
+            name: name.to_string(),
+            r#type: r#type.to_string(),
+        }
+    }
+
+    fn function_entry() -> AbiEntry {
+        AbiEntry::Function(AbiFunction {
+            name: "get_a".to_string(),
+            inputs: vec![],
+            outputs: vec![],
+            state_mutability: StarknetStateMutability::View,
+        })
+    }
+
+    fn constructor_entry() -> AbiEntry {
+        AbiEntry::Constructor(AbiConstructor {
+            name: "constructor".to_string(),
+            inputs: vec![
+                named_member("owner", "core::starknet::contract_address::ContractAddress"),
+                named_member("amount", "core::felt252"),
+                named_member("config", "module::Config"),
+            ],
+        })
+    }
+
+    #[test]
+    fn test_collect_constructor_none() {
+        let entries = vec![function_entry()];
+        let constructor = AbiParser::collect_constructor(&entries, &HashMap::new()).unwrap();
+        assert!(constructor.is_none());
+    }
+
+    #[test]
+    fn test_collect_constructor_inputs_order() {
+        let entries = vec![function_entry(), constructor_entry()];
+        let constructor = AbiParser::collect_constructor(&entries, &HashMap::new())
+            .unwrap()
+            .unwrap();
+
+        assert_eq!(constructor.name, "constructor");
+        assert_eq!(constructor.state_mutability, StateMutability::External);
+        assert!(constructor.outputs.is_empty());
+
+        let names: Vec<&str> = constructor.inputs.iter().map(|(n, _)| n.as_str()).collect();
+        assert_eq!(names, vec!["owner", "amount", "config"]);
+        assert_eq!(
+            constructor.inputs[0].1.type_path(),
+            "core::starknet::contract_address::ContractAddress"
+        );
+        assert_eq!(constructor.inputs[1].1.type_path(), "core::felt252");
+    }
+
+    #[test]
+    fn test_collect_constructor_type_aliases() {
+        let entries = vec![constructor_entry()];
+        let mut type_aliases = HashMap::new();
+        type_aliases.insert("module::Config".to_string(), "MyConfig".to_string());
+
+        let constructor = AbiParser::collect_constructor(&entries, &type_aliases)
+            .unwrap()
+            .unwrap();
+
+        match &constructor.inputs[2].1 {
+            Token::Composite(c) => assert_eq!(c.type_name_or_alias(), "MyConfig"),
+            t => panic!("composite expected, got {:?}", t),
+        }
+    }
+}
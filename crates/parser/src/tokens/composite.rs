@@ -302,6 +302,24 @@ mod tests {
         assert!(expected.is_generic());
     }
 
+    #[test]
+    fn test_parse_generic_builtin_non_zero() {
+        let expected = Composite {
+            type_path: "core::zeroable::NonZero::<core::integer::u64>".to_string(),
+            inners: vec![],
+            generic_args: vec![("A".to_string(), basic_u64())],
+            r#type: CompositeType::Unknown,
+            is_event: false,
+            alias: None,
+        };
+
+        let c = Composite::parse("core::zeroable::NonZero::<core::integer::u64>").unwrap();
+        assert_eq!(c, expected);
+        assert!(c.is_generic());
+        assert!(c.is_builtin());
+        assert_eq!(c.type_name(), "NonZero");
+    }
+
     #[test]
     fn test_type_name() {
         let mut c = Composite {
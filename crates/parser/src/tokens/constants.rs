@@ -1,4 +1,4 @@
-pub const CAIRO_CORE_BASIC: [&str; 16] = [
+pub const CAIRO_CORE_BASIC: [&str; 17] = [
     "core::felt252",
     "core::bool",
     "core::integer::u8",
@@ -14,6 +14,7 @@ pub const CAIRO_CORE_BASIC: [&str; 16] = [
     "core::integer::i128",
     "core::starknet::contract_address::ContractAddress",
     "core::starknet::class_hash::ClassHash",
+    "core::starknet::storage_access::StorageAddress",
     "core::bytes_31::bytes31",
 ];
 
@@ -21,7 +22,11 @@ pub const CAIRO_CORE_BASIC: [&str; 16] = [
 // to match array pattern.
 pub const CAIRO_CORE_SPAN_ARRAY: [&str; 2] = ["core::array::Span", "core::array::Array"];
 
-pub const CAIRO_GENERIC_BUILTINS: [&str; 2] = ["core::option::Option", "core::result::Result"];
+pub const CAIRO_GENERIC_BUILTINS: [&str; 3] = [
+    "core::option::Option",
+    "core::result::Result",
+    "core::zeroable::NonZero",
+];
 
 pub const CAIRO_COMPOSITE_BUILTINS: [&str; 2] = [
     "core::byte_array::ByteArray",
@@ -95,7 +95,7 @@ impl CairoEnum {
             } else {
                 serializations.push(quote! {
                     #enum_name::#variant_name(val) => {
-                        let mut temp = vec![];
+                        let mut temp = Vec::with_capacity(#ty_punctuated::cairo_serialized_size(val) + 1);
                         temp.extend(usize::cairo_serialize(&#variant_index));
                         temp.extend(#ty_punctuated::cairo_serialize(val));
                         temp
@@ -122,7 +122,7 @@ impl CairoEnum {
         });
 
         deserializations.push(quote! {
-            _ => return Err(#ccs::Error::Deserialize(format!("Index not handle for enum {}", #name_str)))
+            _ => return Err(__unknown())
         });
 
         let (impl_line, rust_type) = if composite.is_generic() {
@@ -166,8 +166,23 @@ impl CairoEnum {
                 }
 
                 fn cairo_deserialize(__felts: &[starknet::core::types::FieldElement], __offset: usize) -> #ccs::Result<Self::RustType> {
-                    let __index:u128 = __felts[__offset].try_into().unwrap();
-                    match __index as usize {
+                    if __offset >= __felts.len() {
+                        return Err(#ccs::Error::BufferTooShort {
+                            type_name: #name_str,
+                            expected: __offset + 1,
+                            got: __felts.len(),
+                        });
+                    }
+
+                    let __unknown = || #ccs::Error::UnknownDiscriminant {
+                        type_name: #name_str,
+                        index: __felts[__offset],
+                        offset: __offset,
+                    };
+
+                    let __index: u128 = __felts[__offset].try_into().map_err(|_| __unknown())?;
+                    let __index: usize = __index.try_into().map_err(|_| __unknown())?;
+                    match __index {
                         #(#deserializations),*
                     }
 
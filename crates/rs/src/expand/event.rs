@@ -116,8 +116,7 @@ impl CairoEnumEvent {
                     );
 
                     quote! {
-                        let selector = event.keys[#selector_key_offset];
-                        if selector == starknet::core::utils::get_selector_from_name(#variant_name_str).unwrap_or_else(|_| panic!("Invalid selector for {}", #variant_name_str)) {
+                        if event.keys.get(#selector_key_offset) == Some(&starknet::macros::selector!(#variant_name_str)) {
                             #inner_content
                         }
                     }
@@ -142,8 +141,7 @@ impl CairoEnumEvent {
                     };
 
                     quote! {
-                        let selector = event.keys[#selector_key_offset];
-                        if selector == starknet::core::utils::get_selector_from_name(#variant_name_str).unwrap_or_else(|_| panic!("Invalid selector for {}", #variant_name_str)) {
+                        if event.keys.get(#selector_key_offset) == Some(&starknet::macros::selector!(#variant_name_str)) {
                             let mut key_offset = #selector_key_offset + 1;
                             let mut data_offset = 0;
 
@@ -22,6 +22,7 @@
 use cainome_parser::tokens::{Function, StateMutability, Token};
 use proc_macro2::TokenStream as TokenStream2;
 use quote::quote;
+use syn::Ident;
 
 use crate::expand::types::CairoToRust;
 use crate::expand::utils;
@@ -38,27 +39,64 @@ fn get_func_inputs(inputs: &[(String, Token)]) -> Vec<TokenStream2> {
     out
 }
 
+fn get_func_serializations(inputs: &[(String, Token)]) -> Vec<TokenStream2> {
+    let mut out: Vec<TokenStream2> = vec![];
+
+    for (name, token) in inputs {
+        let name = utils::str_to_ident(name);
+        let ty = utils::str_to_type(&token.to_rust_type_path());
+
+        let ser = match token {
+            Token::Tuple(_) => quote! {
+                __calldata.extend(<#ty>::cairo_serialize(#name));
+            },
+            _ => quote!(__calldata.extend(#ty::cairo_serialize(#name));),
+        };
+
+        out.push(ser);
+    }
+
+    out
+}
+
 pub struct CairoFunction;
 
 impl CairoFunction {
-    pub fn expand(func: &Function, is_for_reader: bool) -> TokenStream2 {
-        let func_name = &func.name;
-        let func_name_ident = utils::str_to_ident(func_name);
+    /// Expands a free function serializing the constructor inputs,
+    /// to be used as the constructor calldata when deploying the contract.
+    pub fn expand_constructor(contract_name: &Ident, func: &Function) -> TokenStream2 {
+        let func_name_ident = utils::str_to_ident(&format!(
+            "{}_constructor_calldata",
+            utils::pascal_to_snake_case(&contract_name.to_string())
+        ));
+
+        let serializations = get_func_serializations(&func.inputs);
+        let inputs = get_func_inputs(&func.inputs);
 
-        let mut serializations: Vec<TokenStream2> = vec![];
-        for (name, token) in &func.inputs {
-            let name = utils::str_to_ident(name);
-            let ty = utils::str_to_type(&token.to_rust_type_path());
+        let snrs_types = utils::snrs_types();
+        let ccs = utils::cainome_cairo_serde();
+
+        quote! {
+            #[allow(clippy::ptr_arg)]
+            #[allow(clippy::too_many_arguments)]
+            pub fn #func_name_ident(
+                #(#inputs),*
+            ) -> Vec<#snrs_types::FieldElement> {
+                use #ccs::CairoSerde;
 
-            let ser = match token {
-                Token::Tuple(_) => quote! {
-                    __calldata.extend(<#ty>::cairo_serialize(#name));
-                },
-                _ => quote!(__calldata.extend(#ty::cairo_serialize(#name));),
-            };
+                let mut __calldata = vec![];
+                #(#serializations)*
 
-            serializations.push(ser);
+                __calldata
+            }
         }
+    }
+
+    pub fn expand(func: &Function, is_for_reader: bool) -> TokenStream2 {
+        let func_name = &func.name;
+        let func_name_ident = utils::str_to_ident(func_name);
+
+        let serializations = get_func_serializations(&func.inputs);
 
         let out_type = if func.outputs.is_empty() {
             quote!(())
@@ -127,7 +127,8 @@ impl CairoStruct {
                 }
 
                 fn cairo_serialize(__rust: &Self::RustType) -> Vec<starknet::core::types::FieldElement> {
-                    let mut __out: Vec<starknet::core::types::FieldElement> = vec![];
+                    let mut __out: Vec<starknet::core::types::FieldElement> =
+                        Vec::with_capacity(Self::cairo_serialized_size(__rust));
                     #(#sers)*
                     __out
                 }
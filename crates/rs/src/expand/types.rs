@@ -103,6 +103,7 @@ fn basic_types_to_rust(type_name: &str) -> String {
         "ClassHash" => format!("{ccsp}::ClassHash"),
         "ContractAddress" => format!("{ccsp}::ContractAddress"),
         "EthAddress" => format!("{ccsp}::EthAddress"),
+        "StorageAddress" => format!("{ccsp}::StorageAddress"),
         "felt252" => "starknet::core::types::FieldElement".to_string(),
         "bytes31" => format!("{ccsp}::Bytes31"),
         "ByteArray" => format!("{ccsp}::ByteArray"),
@@ -116,6 +117,7 @@ fn builtin_composite_to_rust(type_name: &str) -> (String, bool) {
     match type_name {
         "EthAddress" => (format!("{ccsp}::EthAddress"), true),
         "ByteArray" => (format!("{ccsp}::ByteArray"), true),
+        "NonZero" => (format!("{ccsp}::NonZero"), true),
         _ => (type_name.to_string(), false),
     }
 }
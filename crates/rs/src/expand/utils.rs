@@ -23,6 +23,31 @@ pub fn str_to_litint(str_in: &str) -> LitInt {
     LitInt::new(str_in, proc_macro2::Span::call_site())
 }
 
+/// Converts a `PascalCase` identifier into `snake_case`.
+/// Consecutive uppercase letters are kept together (`ERC20Token` -> `erc20_token`).
+pub fn pascal_to_snake_case(s: &str) -> String {
+    let chars: Vec<char> = s.chars().collect();
+    let mut out = String::new();
+
+    for (i, c) in chars.iter().enumerate() {
+        if c.is_uppercase() && i > 0 {
+            let prev = chars[i - 1];
+            let next_is_lower = chars.get(i + 1).is_some_and(|n| n.is_lowercase());
+
+            if prev.is_lowercase()
+                || prev.is_ascii_digit()
+                || (prev.is_uppercase() && next_is_lower)
+            {
+                out.push('_');
+            }
+        }
+
+        out.extend(c.to_lowercase());
+    }
+
+    out
+}
+
 pub fn snrs_types() -> Type {
     str_to_type("starknet::core::types")
 }
@@ -78,3 +103,18 @@ pub fn rust_associated_type_gen_args(entity_name: &Ident, gen_args: &[Ident]) ->
 
     quote!(type RustType = #entity_name<#(#gen_args_rust),*>;)
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_pascal_to_snake_case() {
+        assert_eq!(pascal_to_snake_case("MyContract"), "my_contract");
+        assert_eq!(pascal_to_snake_case("ERC20Token"), "erc20_token");
+        assert_eq!(pascal_to_snake_case("Erc20"), "erc20");
+        assert_eq!(pascal_to_snake_case("ABC"), "abc");
+        assert_eq!(pascal_to_snake_case("contract"), "contract");
+        assert_eq!(pascal_to_snake_case(""), "");
+    }
+}
@@ -25,11 +25,17 @@ fn abigen_internal(input: TokenStream) -> TokenStream {
 
     let abi_tokens = AbiParser::collect_tokens(&abi_entries).expect("failed tokens parsing");
     let abi_tokens = AbiParser::organize_tokens(abi_tokens, &contract_abi.type_aliases);
+    let constructor = AbiParser::collect_constructor(&abi_entries, &contract_abi.type_aliases)
+        .expect("failed constructor parsing");
 
     let mut tokens: Vec<TokenStream2> = vec![];
 
     tokens.push(CairoContract::expand(contract_name.clone()));
 
+    if let Some(c) = &constructor {
+        tokens.push(CairoFunction::expand_constructor(&contract_name, c));
+    }
+
     if let Some(structs) = abi_tokens.get("structs") {
         for s in structs {
             let s_composite = s.to_composite().expect("composite expected");
@@ -4,15 +4,16 @@
 //! passed to the macro. We should then parse the
 //! token stream to ensure the arguments are correct.
 //!
-//! At this moment, the macro supports one fashion:
+//! The ABI is loaded from a `.json` file or from an inline JSON
+//! string, which may contain the ABI array, the Sierra artifact
+//! or the flattened Sierra class (see `abi_entries_from_json`).
+//! abigen!(ContractName, "path/to/abi.json")
 //!
-//! Loading from a file with only the ABI array.
-//! abigen!(ContractName, "path/to/abi.json"
-//!
-//! TODO: support the full artifact JSON to be able to
+//! TODO: keep the full artifact JSON to be able to
 //! deploy contracts from abigen.
 use quote::ToTokens;
-use starknet::core::types::contract::{AbiEntry, SierraClass};
+use serde_json::Value;
+use starknet::core::types::contract::AbiEntry;
 use std::collections::{HashMap, HashSet};
 use std::fs::File;
 use std::path::Path;
@@ -47,7 +48,6 @@ impl Parse for ContractAbi {
         // Path rooted to the Cargo.toml location if it's a file.
         let abi_or_path = input.parse::<LitStr>()?;
 
-        #[allow(clippy::collapsible_else_if)]
         let abi = if abi_or_path.value().ends_with(".json") {
             let json_path = if abi_or_path.value().starts_with(CARGO_MANIFEST_DIR) {
                 let manifest_dir = env!("CARGO_MANIFEST_DIR");
@@ -61,27 +61,17 @@ impl Parse for ContractAbi {
                 abi_or_path
             };
 
-            // To prepare the declare and deploy features, we also
-            // accept a full Sierra artifact for the ABI.
-            // To support declare and deploy, the full class must be stored.
-            if let Ok(sierra) =
-                serde_json::from_reader::<_, SierraClass>(open_json_file(&json_path.value())?)
-            {
-                sierra.abi
-            } else {
-                serde_json::from_reader::<_, Vec<AbiEntry>>(open_json_file(&json_path.value())?)
-                    .map_err(|e| {
-                        syn::Error::new(json_path.span(), format!("JSON parse error: {}", e))
-                    })?
-            }
+            serde_json::from_reader::<_, Value>(open_json_file(&json_path.value())?)
+                .and_then(abi_entries_from_json)
+                .map_err(|e| {
+                    syn::Error::new(json_path.span(), format!("JSON parse error: {}", e))
+                })?
         } else {
-            if let Ok(sierra) = serde_json::from_str::<SierraClass>(&abi_or_path.value()) {
-                sierra.abi
-            } else {
-                serde_json::from_str::<Vec<AbiEntry>>(&abi_or_path.value()).map_err(|e| {
+            serde_json::from_str::<Value>(&abi_or_path.value())
+                .and_then(abi_entries_from_json)
+                .map_err(|e| {
                     syn::Error::new(abi_or_path.span(), format!("JSON parse error: {}", e))
                 })?
-            }
         };
 
         let mut output_path: Option<String> = None;
@@ -159,6 +149,23 @@ impl Parse for TypeAlias {
     }
 }
 
+/// Extracts the ABI entries from the given JSON, which can be:
+///
+/// * The entire Sierra artifact (`*.contract_class.json`), where `abi` is an array.
+/// * A flattened Sierra class (as returned by `starknet_getClass`), where `abi`
+///   is an array serialized into a string.
+/// * Only the array of ABI entries.
+fn abi_entries_from_json(json: Value) -> serde_json::Result<Vec<AbiEntry>> {
+    match json {
+        Value::Object(mut class) => match class.remove("abi") {
+            Some(Value::String(abi)) => serde_json::from_str(&abi),
+            Some(abi) => serde_json::from_value(abi),
+            None => serde_json::from_value(Value::Object(class)),
+        },
+        json => serde_json::from_value(json),
+    }
+}
+
 fn open_json_file(file_path: &str) -> Result<File> {
     File::open(file_path).map_err(|e| {
         syn::Error::new(
@@ -167,4 +174,44 @@ fn open_json_file(file_path: &str) -> Result<File> {
         )
     })
 }
-// TODO: add test for argument parsing.
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    const ABI: &str = r#"[{"type":"function","name":"get_a","inputs":[],"outputs":[{"type":"core::felt252"}],"state_mutability":"view"}]"#;
+
+    fn assert_get_a(entries: Vec<AbiEntry>) {
+        assert_eq!(entries.len(), 1);
+        match &entries[0] {
+            AbiEntry::Function(f) => assert_eq!(f.name, "get_a"),
+            _ => panic!("function entry expected"),
+        }
+    }
+
+    #[test]
+    fn test_abi_entries_from_abi_array() {
+        let json: Value = serde_json::from_str(ABI).unwrap();
+        assert_get_a(abi_entries_from_json(json).unwrap());
+    }
+
+    #[test]
+    fn test_abi_entries_from_sierra_class() {
+        let json: Value = serde_json::from_str(&format!(
+            r#"{{"sierra_program":[],"contract_class_version":"0.1.0","abi":{}}}"#,
+            ABI
+        ))
+        .unwrap();
+        assert_get_a(abi_entries_from_json(json).unwrap());
+    }
+
+    #[test]
+    fn test_abi_entries_from_flattened_sierra_class() {
+        let json = serde_json::json!({
+            "sierra_program": [],
+            "contract_class_version": "0.1.0",
+            "abi": ABI,
+        });
+        assert_get_a(abi_entries_from_json(json).unwrap());
+    }
+}